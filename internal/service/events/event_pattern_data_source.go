@@ -0,0 +1,318 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_cloudwatch_event_pattern", name="Event Pattern")
+func DataSourceEventPattern() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceEventPatternRead,
+
+		Schema: map[string]*schema.Schema{
+			"event_pattern": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsJSON,
+			},
+			"events": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsJSON,
+				},
+			},
+			"matched_events": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringMatch(regexache.MustCompile(`^`+validNameCharClass+`$`), "must contain only alphanumeric, period, hyphen, and underscore characters"),
+			},
+			"normalized_pattern": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"unmatched_events": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceEventPatternRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var pattern map[string]interface{}
+	if err := json.Unmarshal([]byte(d.Get("event_pattern").(string)), &pattern); err != nil {
+		return sdkdiag.AppendErrorf(diags, "parsing event_pattern: %s", err)
+	}
+
+	normalized, err := json.Marshal(pattern)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "normalizing event_pattern: %s", err)
+	}
+
+	var matched, unmatched []string
+
+	for _, raw := range d.Get("events").([]interface{}) {
+		eventJSON := raw.(string)
+
+		var event map[string]interface{}
+		if err := json.Unmarshal([]byte(eventJSON), &event); err != nil {
+			return sdkdiag.AppendErrorf(diags, "parsing sample event: %s", err)
+		}
+
+		if matchesPattern(pattern, event) {
+			matched = append(matched, eventJSON)
+		} else {
+			unmatched = append(unmatched, eventJSON)
+		}
+	}
+
+	d.SetId(string(normalized))
+	d.Set("normalized_pattern", string(normalized))
+	d.Set("matched_events", matched)
+	d.Set("unmatched_events", unmatched)
+
+	return diags
+}
+
+// matchesPattern implements the subset of EventBridge's content-based
+// filtering semantics documented at
+// https://docs.aws.amazon.com/eventbridge/latest/userguide/eb-event-patterns-content-based-filtering.html
+// so that malformed patterns and false-negative filters surface at plan
+// time instead of at rule-evaluation time.
+func matchesPattern(pattern, event map[string]interface{}) bool {
+	for key, value := range pattern {
+		if key == "$or" {
+			alternatives, ok := value.([]interface{})
+			if !ok {
+				return false
+			}
+
+			matchedAny := false
+			for _, alt := range alternatives {
+				altPattern, ok := alt.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				if matchesPattern(altPattern, event) {
+					matchedAny = true
+					break
+				}
+			}
+			if !matchedAny {
+				return false
+			}
+			continue
+		}
+
+		eventValue, exists := event[key]
+
+		switch v := value.(type) {
+		case map[string]interface{}:
+			// A nested object in the pattern matches a nested object in the event.
+			nestedEvent, ok := eventValue.(map[string]interface{})
+			if !exists || !ok || !matchesPattern(v, nestedEvent) {
+				return false
+			}
+		case []interface{}:
+			if !matchesMatchers(v, eventValue, exists) {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+// matchesMatchers evaluates one field's list of matchers. A field matches if
+// ANY matcher in the list matches (EventBridge's array-of-filters is an OR).
+func matchesMatchers(matchers []interface{}, eventValue interface{}, exists bool) bool {
+	for _, m := range matchers {
+		if matchesOneMatcher(m, eventValue, exists) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func matchesOneMatcher(matcher, eventValue interface{}, exists bool) bool {
+	rule, ok := matcher.(map[string]interface{})
+	if !ok {
+		// A bare literal (string, number, bool) must equal the event value exactly.
+		return exists && literalEqual(matcher, eventValue)
+	}
+
+	for ruleType, ruleValue := range rule {
+		switch ruleType {
+		case "exists":
+			want, _ := ruleValue.(bool)
+			return exists == want
+		case "prefix":
+			s, ok1 := eventValue.(string)
+			prefix, ok2 := ruleValue.(string)
+			return exists && ok1 && ok2 && strings.HasPrefix(s, prefix)
+		case "suffix":
+			s, ok1 := eventValue.(string)
+			suffix, ok2 := ruleValue.(string)
+			return exists && ok1 && ok2 && strings.HasSuffix(s, suffix)
+		case "wildcard":
+			s, ok1 := eventValue.(string)
+			pattern, ok2 := ruleValue.(string)
+			return exists && ok1 && ok2 && matchesWildcard(pattern, s)
+		case "cidr":
+			s, ok1 := eventValue.(string)
+			cidr, ok2 := ruleValue.(string)
+			return exists && ok1 && ok2 && matchesCIDR(cidr, s)
+		case "anything-but":
+			if !exists {
+				return false
+			}
+			switch excl := ruleValue.(type) {
+			case []interface{}:
+				for _, v := range excl {
+					if literalEqual(v, eventValue) {
+						return false
+					}
+				}
+				return true
+			case map[string]interface{}:
+				// e.g. {"anything-but": {"prefix": "foo"}} or {"anything-but": {"suffix": "bar"}}.
+				return !matchesOneMatcher(excl, eventValue, exists)
+			default:
+				return !literalEqual(excl, eventValue)
+			}
+		case "numeric":
+			conditions, ok := ruleValue.([]interface{})
+			return exists && ok && matchesNumeric(conditions, eventValue)
+		}
+	}
+
+	return false
+}
+
+func literalEqual(a, b interface{}) bool {
+	aj, err1 := json.Marshal(a)
+	bj, err2 := json.Marshal(b)
+	return err1 == nil && err2 == nil && string(aj) == string(bj)
+}
+
+func matchesWildcard(pattern, s string) bool {
+	parts := strings.Split(pattern, "*")
+	if len(parts) == 1 {
+		return pattern == s
+	}
+
+	if !strings.HasPrefix(s, parts[0]) {
+		return false
+	}
+	s = s[len(parts[0]):]
+
+	for _, part := range parts[1 : len(parts)-1] {
+		idx := strings.Index(s, part)
+		if idx < 0 {
+			return false
+		}
+		s = s[idx+len(part):]
+	}
+
+	return strings.HasSuffix(s, parts[len(parts)-1])
+}
+
+func matchesCIDR(cidr, ip string) bool {
+	_, network, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return false
+	}
+
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return false
+	}
+
+	return network.Contains(parsed)
+}
+
+func matchesNumeric(conditions []interface{}, eventValue interface{}) bool {
+	n, ok := toFloat64(eventValue)
+	if !ok {
+		return false
+	}
+
+	for i := 0; i+1 < len(conditions); i += 2 {
+		op, ok1 := conditions[i].(string)
+		want, ok2 := toFloat64(conditions[i+1])
+		if !ok1 || !ok2 {
+			return false
+		}
+
+		switch op {
+		case "=":
+			if n != want {
+				return false
+			}
+		case "!=":
+			if n == want {
+				return false
+			}
+		case "<":
+			if !(n < want) {
+				return false
+			}
+		case "<=":
+			if !(n <= want) {
+				return false
+			}
+		case ">":
+			if !(n > want) {
+				return false
+			}
+		case ">=":
+			if !(n >= want) {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+func toFloat64(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case int:
+		return float64(n), true
+	case json.Number:
+		f, err := n.Float64()
+		return f, err == nil
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package events
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestMatchesPattern(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		pattern string
+		event   string
+		want    bool
+	}{
+		"literal match": {
+			pattern: `{"source":["aws.ec2"]}`,
+			event:   `{"source":"aws.ec2"}`,
+			want:    true,
+		},
+		"literal mismatch": {
+			pattern: `{"source":["aws.ec2"]}`,
+			event:   `{"source":"aws.s3"}`,
+			want:    false,
+		},
+		"prefix match": {
+			pattern: `{"detail":{"state":[{"prefix":"run"}]}}`,
+			event:   `{"detail":{"state":"running"}}`,
+			want:    true,
+		},
+		"suffix match": {
+			pattern: `{"detail":{"state":[{"suffix":"ing"}]}}`,
+			event:   `{"detail":{"state":"running"}}`,
+			want:    true,
+		},
+		"wildcard match": {
+			pattern: `{"detail":{"name":[{"wildcard":"foo*bar"}]}}`,
+			event:   `{"detail":{"name":"foobazbar"}}`,
+			want:    true,
+		},
+		"cidr match": {
+			pattern: `{"detail":{"ip":[{"cidr":"10.0.0.0/8"}]}}`,
+			event:   `{"detail":{"ip":"10.1.2.3"}}`,
+			want:    true,
+		},
+		"anything-but literal excludes": {
+			pattern: `{"detail":{"state":[{"anything-but":"running"}]}}`,
+			event:   `{"detail":{"state":"running"}}`,
+			want:    false,
+		},
+		"anything-but literal allows": {
+			pattern: `{"detail":{"state":[{"anything-but":"running"}]}}`,
+			event:   `{"detail":{"state":"stopped"}}`,
+			want:    true,
+		},
+		"anything-but prefix excludes": {
+			pattern: `{"detail":{"state":[{"anything-but":{"prefix":"run"}}]}}`,
+			event:   `{"detail":{"state":"running"}}`,
+			want:    false,
+		},
+		"anything-but prefix allows": {
+			pattern: `{"detail":{"state":[{"anything-but":{"prefix":"run"}}]}}`,
+			event:   `{"detail":{"state":"stopped"}}`,
+			want:    true,
+		},
+		"numeric range match": {
+			pattern: `{"detail":{"count":[{"numeric":[">",0,"<=",5]}]}}`,
+			event:   `{"detail":{"count":3}}`,
+			want:    true,
+		},
+		"numeric range mismatch": {
+			pattern: `{"detail":{"count":[{"numeric":[">",0,"<=",5]}]}}`,
+			event:   `{"detail":{"count":10}}`,
+			want:    false,
+		},
+		"exists true": {
+			pattern: `{"detail":{"state":[{"exists":true}]}}`,
+			event:   `{"detail":{"state":"running"}}`,
+			want:    true,
+		},
+		"exists false": {
+			pattern: `{"detail":{"state":[{"exists":false}]}}`,
+			event:   `{"detail":{}}`,
+			want:    true,
+		},
+		"$or match": {
+			pattern: `{"$or":[{"source":["aws.ec2"]},{"source":["aws.s3"]}]}`,
+			event:   `{"source":"aws.s3"}`,
+			want:    true,
+		},
+		"$or mismatch": {
+			pattern: `{"$or":[{"source":["aws.ec2"]},{"source":["aws.s3"]}]}`,
+			event:   `{"source":"aws.rds"}`,
+			want:    false,
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			pattern := unmarshalJSONMap(t, tc.pattern)
+			event := unmarshalJSONMap(t, tc.event)
+
+			got := matchesPattern(pattern, event)
+			if got != tc.want {
+				t.Errorf("matchesPattern(%s, %s) = %t, want %t", tc.pattern, tc.event, got, tc.want)
+			}
+		})
+	}
+}
+
+func unmarshalJSONMap(t *testing.T, s string) map[string]interface{} {
+	t.Helper()
+
+	var m map[string]interface{}
+	if err := json.Unmarshal([]byte(s), &m); err != nil {
+		t.Fatalf("unmarshaling %s: %s", s, err)
+	}
+
+	return m
+}
@@ -0,0 +1,1593 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package events
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/pipes"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/pipes/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_pipes_pipe", name="Pipe")
+// @Tags(identifierAttribute="arn")
+func ResourcePipe() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePipeCreate,
+		ReadWithoutTimeout:   resourcePipeRead,
+		UpdateWithoutTimeout: resourcePipeUpdate,
+		DeleteWithoutTimeout: resourcePipeDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 512),
+			},
+			"desired_state": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          awstypes.RequestedPipeStateRunning,
+				ValidateDiagFunc: enum.Validate[awstypes.RequestedPipeState](),
+			},
+			"enrichment": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"enrichment_parameters": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"http_parameters": pipeHTTPParametersSchema(),
+						"input_template": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 8192),
+						},
+					},
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validPipeName,
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"source": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"source_parameters": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"activemq_broker_parameters": pipeBrokerSourceParametersSchema(),
+						"dynamodb_stream_parameters": pipeStreamSourceParametersSchema(),
+						"filter_criteria": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"filter": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 5,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"pattern": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringLenBetween(0, 4096),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"kinesis_stream_parameters":          pipeStreamSourceParametersSchema(),
+						"managed_streaming_kafka_parameters": pipeKafkaSourceParametersSchema(),
+						"rabbitmq_broker_parameters":         pipeBrokerSourceParametersSchema(),
+						"self_managed_kafka_parameters":      pipeKafkaSourceParametersSchema(),
+						"sqs_queue_parameters": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"batch_size": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(1, 10000),
+									},
+									"maximum_batching_window_in_seconds": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(0, 300),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"target": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"target_parameters": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"batch_job_parameters": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"job_definition": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"job_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"cloudwatch_logs_parameters": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"log_stream_name": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"timestamp": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"ecs_task_parameters": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"task_definition_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"task_count": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+								},
+							},
+						},
+						"eventbridge_event_bus_parameters": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"detail_type": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"endpoint_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"resources": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"source": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"http_parameters": pipeHTTPParametersSchema(),
+						"input_template": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 8192),
+						},
+						"kinesis_stream_parameters": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"partition_key": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 256),
+									},
+								},
+							},
+						},
+						"lambda_function_parameters": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"invocation_type": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										ValidateDiagFunc: enum.Validate[awstypes.PipeTargetInvocationType](),
+									},
+								},
+							},
+						},
+						"redshift_data_parameters": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"database": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"db_user": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"secret_manager_arn": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"sqls": {
+										Type:     schema.TypeList,
+										Required: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"statement_name": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"with_event": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"sagemaker_pipeline_parameters": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"pipeline_parameter": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"name": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"value": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"sqs_queue_parameters": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"message_deduplication_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"message_group_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"step_function_state_machine_parameters": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"invocation_type": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										ValidateDiagFunc: enum.Validate[awstypes.PipeTargetInvocationType](),
+									},
+								},
+							},
+						},
+						"timestream_parameters": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"time_value": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"version_value": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func pipeHTTPParametersSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"header_parameters": {
+					Type:     schema.TypeMap,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"path_parameter_values": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"query_string_parameters": {
+					Type:     schema.TypeMap,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+}
+
+func pipeStreamSourceParametersSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"batch_size": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntBetween(1, 10000),
+				},
+				"dead_letter_config": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"arn": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: verify.ValidARN,
+							},
+						},
+					},
+				},
+				"maximum_batching_window_in_seconds": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntBetween(0, 300),
+				},
+				"maximum_record_age_in_seconds": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntBetween(-1, 604800),
+				},
+				"maximum_retry_attempts": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntBetween(-1, 10000),
+				},
+				"on_partial_batch_item_failure": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					ValidateDiagFunc: enum.Validate[awstypes.OnPartialBatchItemFailureStreams](),
+				},
+				"parallelization_factor": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntBetween(1, 10),
+				},
+				"starting_position": {
+					Type:             schema.TypeString,
+					Required:         true,
+					ValidateDiagFunc: enum.Validate[awstypes.PipeSourceDynamoDBStreamStartPosition](),
+				},
+			},
+		},
+	}
+}
+
+func pipeKafkaSourceParametersSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"batch_size": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntBetween(1, 10000),
+				},
+				"consumer_group_id": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"credentials": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"client_certificate_tls_auth": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: verify.ValidARN,
+							},
+							"sasl_scram_512_auth": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: verify.ValidARN,
+							},
+						},
+					},
+				},
+				"maximum_batching_window_in_seconds": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntBetween(0, 300),
+				},
+				"server_root_ca_certificate": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: verify.ValidARN,
+				},
+				"starting_position": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					ValidateDiagFunc: enum.Validate[awstypes.MSKStartPosition](),
+				},
+				"topic_name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 249),
+				},
+				"vpc": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"security_groups": {
+								Type:     schema.TypeList,
+								Optional: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							"subnets": {
+								Type:     schema.TypeList,
+								Optional: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func pipeBrokerSourceParametersSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"batch_size": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntBetween(1, 10000),
+				},
+				"credentials": {
+					Type:     schema.TypeList,
+					Required: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"basic_auth": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: verify.ValidARN,
+							},
+						},
+					},
+				},
+				"maximum_batching_window_in_seconds": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntBetween(0, 300),
+				},
+				"queue_name": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+const (
+	pipeCreatedTimeout = 2 * time.Minute
+	pipeUpdatedTimeout = 2 * time.Minute
+	pipeDeletedTimeout = 2 * time.Minute
+)
+
+func resourcePipeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).PipesClient(ctx)
+
+	name := create.Name(d.Get("name").(string), "pipe")
+	input := &pipes.CreatePipeInput{
+		Name:    aws.String(name),
+		RoleArn: aws.String(d.Get("role_arn").(string)),
+		Source:  aws.String(d.Get("source").(string)),
+		Tags:    getTagsIn(ctx),
+		Target:  aws.String(d.Get("target").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("desired_state"); ok {
+		input.DesiredState = awstypes.RequestedPipeState(v.(string))
+	}
+
+	if v, ok := d.GetOk("enrichment"); ok {
+		input.Enrichment = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("enrichment_parameters"); ok && len(v.([]interface{})) > 0 {
+		input.EnrichmentParameters = expandPipeEnrichmentParameters(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("source_parameters"); ok && len(v.([]interface{})) > 0 {
+		input.SourceParameters = expandPipeSourceParameters(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("target_parameters"); ok && len(v.([]interface{})) > 0 {
+		input.TargetParameters = expandPipeTargetParameters(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	output, err := conn.CreatePipe(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating EventBridge Pipes Pipe (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.Name))
+
+	if _, err := waitPipeCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for EventBridge Pipes Pipe (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourcePipeRead(ctx, d, meta)...)
+}
+
+func resourcePipeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).PipesClient(ctx)
+
+	out, err := FindPipeByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EventBridge Pipes Pipe (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EventBridge Pipes Pipe (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", out.Arn)
+	d.Set("description", out.Description)
+	d.Set("desired_state", out.DesiredState)
+	d.Set("enrichment", out.Enrichment)
+	if err := d.Set("enrichment_parameters", flattenPipeEnrichmentParameters(out.EnrichmentParameters)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting enrichment_parameters: %s", err)
+	}
+	d.Set("name", out.Name)
+	d.Set("role_arn", out.RoleArn)
+	d.Set("source", out.Source)
+	if err := d.Set("source_parameters", flattenPipeSourceParameters(out.SourceParameters)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting source_parameters: %s", err)
+	}
+	d.Set("target", out.Target)
+	if err := d.Set("target_parameters", flattenPipeTargetParameters(out.TargetParameters)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting target_parameters: %s", err)
+	}
+
+	setTagsOut(ctx, out.Tags)
+
+	return diags
+}
+
+func resourcePipeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).PipesClient(ctx)
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &pipes.UpdatePipeInput{
+			Name:    aws.String(d.Id()),
+			RoleArn: aws.String(d.Get("role_arn").(string)),
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("desired_state") {
+			input.DesiredState = awstypes.RequestedPipeState(d.Get("desired_state").(string))
+		}
+
+		if d.HasChange("enrichment") {
+			input.Enrichment = aws.String(d.Get("enrichment").(string))
+		}
+
+		if v, ok := d.GetOk("enrichment_parameters"); ok && len(v.([]interface{})) > 0 {
+			input.EnrichmentParameters = expandPipeEnrichmentParameters(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		if v, ok := d.GetOk("target_parameters"); ok && len(v.([]interface{})) > 0 {
+			input.TargetParameters = expandPipeTargetParameters(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		_, err := conn.UpdatePipe(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating EventBridge Pipes Pipe (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitPipeUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for EventBridge Pipes Pipe (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourcePipeRead(ctx, d, meta)...)
+}
+
+func resourcePipeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).PipesClient(ctx)
+
+	log.Printf("[INFO] Deleting EventBridge Pipes Pipe: %s", d.Id())
+	_, err := conn.DeletePipe(ctx, &pipes.DeletePipeInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting EventBridge Pipes Pipe (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitPipeDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for EventBridge Pipes Pipe (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindPipeByName(ctx context.Context, conn *pipes.Client, name string) (*pipes.DescribePipeOutput, error) {
+	input := &pipes.DescribePipeInput{
+		Name: aws.String(name),
+	}
+
+	out, err := conn.DescribePipe(ctx, input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return out, nil
+}
+
+func statusPipe(ctx context.Context, conn *pipes.Client, name string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		out, err := FindPipeByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return out, string(out.CurrentState), nil
+	}
+}
+
+func waitPipeCreated(ctx context.Context, conn *pipes.Client, name string, timeout time.Duration) (*pipes.DescribePipeOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.PipeStateCreating, awstypes.PipeStateStarting),
+		Target:  enum.Slice(awstypes.PipeStateRunning, awstypes.PipeStateStopped),
+		Refresh: statusPipe(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if out, ok := outputRaw.(*pipes.DescribePipeOutput); ok {
+		return out, err
+	}
+
+	return nil, err
+}
+
+func waitPipeUpdated(ctx context.Context, conn *pipes.Client, name string, timeout time.Duration) (*pipes.DescribePipeOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.PipeStateUpdating, awstypes.PipeStateStarting, awstypes.PipeStateStopping),
+		Target:  enum.Slice(awstypes.PipeStateRunning, awstypes.PipeStateStopped),
+		Refresh: statusPipe(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if out, ok := outputRaw.(*pipes.DescribePipeOutput); ok {
+		return out, err
+	}
+
+	return nil, err
+}
+
+func waitPipeDeleted(ctx context.Context, conn *pipes.Client, name string, timeout time.Duration) (*pipes.DescribePipeOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.PipeStateDeleting),
+		Target:  []string{},
+		Refresh: statusPipe(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if out, ok := outputRaw.(*pipes.DescribePipeOutput); ok {
+		return out, err
+	}
+
+	return nil, err
+}
+
+func expandPipeEnrichmentParameters(tfMap map[string]interface{}) *awstypes.PipeEnrichmentParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.PipeEnrichmentParameters{}
+
+	if v, ok := tfMap["input_template"].(string); ok && v != "" {
+		apiObject.InputTemplate = aws.String(v)
+	}
+
+	if v, ok := tfMap["http_parameters"].([]interface{}); ok && len(v) > 0 {
+		apiObject.HttpParameters = expandPipeHTTPParameters(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func flattenPipeEnrichmentParameters(apiObject *awstypes.PipeEnrichmentParameters) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"input_template": aws.ToString(apiObject.InputTemplate),
+	}
+
+	if apiObject.HttpParameters != nil {
+		tfMap["http_parameters"] = []interface{}{flattenPipeHTTPParameters(apiObject.HttpParameters)}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func expandPipeHTTPParameters(tfMap map[string]interface{}) *awstypes.PipeEnrichmentHttpParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.PipeEnrichmentHttpParameters{}
+
+	if v, ok := tfMap["header_parameters"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.HeaderParameters = flex.ExpandStringValueMap(v)
+	}
+
+	if v, ok := tfMap["path_parameter_values"].([]interface{}); ok && len(v) > 0 {
+		apiObject.PathParameterValues = flex.ExpandStringValueList(v)
+	}
+
+	if v, ok := tfMap["query_string_parameters"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.QueryStringParameters = flex.ExpandStringValueMap(v)
+	}
+
+	return apiObject
+}
+
+func flattenPipeHTTPParameters(apiObject *awstypes.PipeEnrichmentHttpParameters) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"header_parameters":       apiObject.HeaderParameters,
+		"path_parameter_values":   apiObject.PathParameterValues,
+		"query_string_parameters": apiObject.QueryStringParameters,
+	}
+}
+
+func expandPipeSourceParameters(tfMap map[string]interface{}) *awstypes.PipeSourceParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.PipeSourceParameters{}
+
+	if v, ok := tfMap["filter_criteria"].([]interface{}); ok && len(v) > 0 {
+		apiObject.FilterCriteria = expandPipeFilterCriteria(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["sqs_queue_parameters"].([]interface{}); ok && len(v) > 0 {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.SqsQueueParameters = &awstypes.PipeSourceSqsQueueParameters{}
+
+		if v, ok := tfMap["batch_size"].(int); ok && v != 0 {
+			apiObject.SqsQueueParameters.BatchSize = aws.Int32(int32(v))
+		}
+
+		if v, ok := tfMap["maximum_batching_window_in_seconds"].(int); ok && v != 0 {
+			apiObject.SqsQueueParameters.MaximumBatchingWindowInSeconds = aws.Int32(int32(v))
+		}
+	}
+
+	if v, ok := tfMap["kinesis_stream_parameters"].([]interface{}); ok && len(v) > 0 {
+		apiObject.KinesisStreamParameters = expandPipeKinesisStreamSourceParameters(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["dynamodb_stream_parameters"].([]interface{}); ok && len(v) > 0 {
+		apiObject.DynamoDBStreamParameters = expandPipeDynamoDBStreamSourceParameters(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["managed_streaming_kafka_parameters"].([]interface{}); ok && len(v) > 0 {
+		apiObject.ManagedStreamingKafkaParameters = expandPipeMSKSourceParameters(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["self_managed_kafka_parameters"].([]interface{}); ok && len(v) > 0 {
+		apiObject.SelfManagedKafkaParameters = expandPipeSelfManagedKafkaSourceParameters(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["activemq_broker_parameters"].([]interface{}); ok && len(v) > 0 {
+		apiObject.ActiveMQBrokerParameters = expandPipeActiveMQBrokerSourceParameters(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["rabbitmq_broker_parameters"].([]interface{}); ok && len(v) > 0 {
+		apiObject.RabbitMQBrokerParameters = expandPipeRabbitMQBrokerSourceParameters(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandPipeFilterCriteria(tfMap map[string]interface{}) *awstypes.FilterCriteria {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.FilterCriteria{}
+
+	if v, ok := tfMap["filter"].([]interface{}); ok && len(v) > 0 {
+		filters := make([]awstypes.Filter, 0, len(v))
+		for _, raw := range v {
+			tfMap, ok := raw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			filters = append(filters, awstypes.Filter{
+				Pattern: aws.String(tfMap["pattern"].(string)),
+			})
+		}
+		apiObject.Filters = filters
+	}
+
+	return apiObject
+}
+
+func expandPipeKinesisStreamSourceParameters(tfMap map[string]interface{}) *awstypes.PipeSourceKinesisStreamParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.PipeSourceKinesisStreamParameters{
+		StartingPosition: awstypes.PipeSourceDynamoDBStreamStartPosition(tfMap["starting_position"].(string)),
+	}
+
+	expandPipeStreamCommonSourceParameters(tfMap, apiObject)
+
+	return apiObject
+}
+
+func expandPipeDynamoDBStreamSourceParameters(tfMap map[string]interface{}) *awstypes.PipeSourceDynamoDBStreamParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.PipeSourceDynamoDBStreamParameters{
+		StartingPosition: awstypes.PipeSourceDynamoDBStreamStartPosition(tfMap["starting_position"].(string)),
+	}
+
+	expandPipeStreamCommonSourceParameters(tfMap, apiObject)
+
+	return apiObject
+}
+
+// expandPipeStreamCommonSourceParameters sets batch_size,
+// maximum_batching_window_in_seconds and dead_letter_config on the Kinesis
+// and DynamoDB Streams source parameter shapes. The other stream settings in
+// the schema (maximum_record_age_in_seconds, maximum_retry_attempts,
+// on_partial_batch_item_failure, parallelization_factor) are not sent.
+func expandPipeStreamCommonSourceParameters(tfMap map[string]interface{}, apiObject interface{}) {
+	batchSize := int32(tfMap["batch_size"].(int))
+	window := int32(tfMap["maximum_batching_window_in_seconds"].(int))
+
+	switch o := apiObject.(type) {
+	case *awstypes.PipeSourceKinesisStreamParameters:
+		o.BatchSize = aws.Int32(batchSize)
+		o.MaximumBatchingWindowInSeconds = aws.Int32(window)
+		if v, ok := tfMap["dead_letter_config"].([]interface{}); ok && len(v) > 0 {
+			dlq := v[0].(map[string]interface{})
+			o.DeadLetterConfig = &awstypes.DeadLetterConfig{Arn: aws.String(dlq["arn"].(string))}
+		}
+	case *awstypes.PipeSourceDynamoDBStreamParameters:
+		o.BatchSize = aws.Int32(batchSize)
+		o.MaximumBatchingWindowInSeconds = aws.Int32(window)
+		if v, ok := tfMap["dead_letter_config"].([]interface{}); ok && len(v) > 0 {
+			dlq := v[0].(map[string]interface{})
+			o.DeadLetterConfig = &awstypes.DeadLetterConfig{Arn: aws.String(dlq["arn"].(string))}
+		}
+	}
+}
+
+func expandPipeMSKSourceParameters(tfMap map[string]interface{}) *awstypes.PipeSourceManagedStreamingKafkaParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.PipeSourceManagedStreamingKafkaParameters{
+		TopicName: aws.String(tfMap["topic_name"].(string)),
+	}
+
+	if v, ok := tfMap["consumer_group_id"].(string); ok && v != "" {
+		apiObject.ConsumerGroupID = aws.String(v)
+	}
+
+	if v, ok := tfMap["starting_position"].(string); ok && v != "" {
+		apiObject.StartingPosition = awstypes.MSKStartPosition(v)
+	}
+
+	if v, ok := tfMap["batch_size"].(int); ok && v != 0 {
+		apiObject.BatchSize = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["maximum_batching_window_in_seconds"].(int); ok && v != 0 {
+		apiObject.MaximumBatchingWindowInSeconds = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["credentials"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Credentials = expandPipeKafkaAccessCredentials(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandPipeSelfManagedKafkaSourceParameters(tfMap map[string]interface{}) *awstypes.PipeSourceSelfManagedKafkaParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.PipeSourceSelfManagedKafkaParameters{
+		TopicName: aws.String(tfMap["topic_name"].(string)),
+	}
+
+	if v, ok := tfMap["consumer_group_id"].(string); ok && v != "" {
+		apiObject.ConsumerGroupID = aws.String(v)
+	}
+
+	if v, ok := tfMap["batch_size"].(int); ok && v != 0 {
+		apiObject.BatchSize = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["maximum_batching_window_in_seconds"].(int); ok && v != 0 {
+		apiObject.MaximumBatchingWindowInSeconds = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["server_root_ca_certificate"].(string); ok && v != "" {
+		apiObject.ServerRootCaCertificate = aws.String(v)
+	}
+
+	if v, ok := tfMap["credentials"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Credentials = expandPipeKafkaAccessCredentials(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["vpc"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Vpc = expandPipeSelfManagedKafkaAccessConfigurationVPC(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandPipeKafkaAccessCredentials(tfMap map[string]interface{}) awstypes.SelfManagedKafkaAccessConfigurationCredentials {
+	if v, ok := tfMap["client_certificate_tls_auth"].(string); ok && v != "" {
+		return &awstypes.SelfManagedKafkaAccessConfigurationCredentialsMemberClientCertificateTlsAuth{Value: v}
+	}
+
+	if v, ok := tfMap["sasl_scram_512_auth"].(string); ok && v != "" {
+		return &awstypes.SelfManagedKafkaAccessConfigurationCredentialsMemberSaslScram512Auth{Value: v}
+	}
+
+	return nil
+}
+
+func expandPipeSelfManagedKafkaAccessConfigurationVPC(tfMap map[string]interface{}) *awstypes.SelfManagedKafkaAccessConfigurationVpc {
+	apiObject := &awstypes.SelfManagedKafkaAccessConfigurationVpc{}
+
+	if v, ok := tfMap["security_groups"].([]interface{}); ok && len(v) > 0 {
+		apiObject.SecurityGroup = flex.ExpandStringValueList(v)
+	}
+
+	if v, ok := tfMap["subnets"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Subnets = flex.ExpandStringValueList(v)
+	}
+
+	return apiObject
+}
+
+func expandPipeActiveMQBrokerSourceParameters(tfMap map[string]interface{}) *awstypes.PipeSourceActiveMQBrokerParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.PipeSourceActiveMQBrokerParameters{
+		QueueName: aws.String(tfMap["queue_name"].(string)),
+	}
+
+	if v, ok := tfMap["credentials"].([]interface{}); ok && len(v) > 0 {
+		creds := v[0].(map[string]interface{})
+		apiObject.Credentials = &awstypes.MQBrokerAccessCredentialsMemberBasicAuth{
+			Value: creds["basic_auth"].(string),
+		}
+	}
+
+	if v, ok := tfMap["batch_size"].(int); ok && v != 0 {
+		apiObject.BatchSize = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["maximum_batching_window_in_seconds"].(int); ok && v != 0 {
+		apiObject.MaximumBatchingWindowInSeconds = aws.Int32(int32(v))
+	}
+
+	return apiObject
+}
+
+func expandPipeRabbitMQBrokerSourceParameters(tfMap map[string]interface{}) *awstypes.PipeSourceRabbitMQBrokerParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.PipeSourceRabbitMQBrokerParameters{
+		QueueName: aws.String(tfMap["queue_name"].(string)),
+	}
+
+	if v, ok := tfMap["credentials"].([]interface{}); ok && len(v) > 0 {
+		creds := v[0].(map[string]interface{})
+		apiObject.Credentials = &awstypes.MQBrokerAccessCredentialsMemberBasicAuth{
+			Value: creds["basic_auth"].(string),
+		}
+	}
+
+	if v, ok := tfMap["batch_size"].(int); ok && v != 0 {
+		apiObject.BatchSize = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["maximum_batching_window_in_seconds"].(int); ok && v != 0 {
+		apiObject.MaximumBatchingWindowInSeconds = aws.Int32(int32(v))
+	}
+
+	return apiObject
+}
+
+func flattenPipeKafkaAccessCredentials(apiObject awstypes.SelfManagedKafkaAccessConfigurationCredentials) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	switch v := apiObject.(type) {
+	case *awstypes.SelfManagedKafkaAccessConfigurationCredentialsMemberClientCertificateTlsAuth:
+		return []interface{}{map[string]interface{}{
+			"client_certificate_tls_auth": v.Value,
+		}}
+	case *awstypes.SelfManagedKafkaAccessConfigurationCredentialsMemberSaslScram512Auth:
+		return []interface{}{map[string]interface{}{
+			"sasl_scram_512_auth": v.Value,
+		}}
+	}
+
+	return nil
+}
+
+func flattenPipeSelfManagedKafkaAccessConfigurationVPC(apiObject *awstypes.SelfManagedKafkaAccessConfigurationVpc) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"security_groups": apiObject.SecurityGroup,
+		"subnets":         apiObject.Subnets,
+	}}
+}
+
+func flattenPipeBrokerAccessCredentials(apiObject awstypes.MQBrokerAccessCredentials) []interface{} {
+	if v, ok := apiObject.(*awstypes.MQBrokerAccessCredentialsMemberBasicAuth); ok {
+		return []interface{}{map[string]interface{}{
+			"basic_auth": v.Value,
+		}}
+	}
+
+	return nil
+}
+
+func flattenPipeSourceParameters(apiObject *awstypes.PipeSourceParameters) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if apiObject.KinesisStreamParameters != nil {
+		tfMap["kinesis_stream_parameters"] = []interface{}{map[string]interface{}{
+			"batch_size":                         aws.ToInt32(apiObject.KinesisStreamParameters.BatchSize),
+			"maximum_batching_window_in_seconds": aws.ToInt32(apiObject.KinesisStreamParameters.MaximumBatchingWindowInSeconds),
+			"starting_position":                  apiObject.KinesisStreamParameters.StartingPosition,
+		}}
+	}
+
+	if apiObject.DynamoDBStreamParameters != nil {
+		tfMap["dynamodb_stream_parameters"] = []interface{}{map[string]interface{}{
+			"batch_size":                         aws.ToInt32(apiObject.DynamoDBStreamParameters.BatchSize),
+			"maximum_batching_window_in_seconds": aws.ToInt32(apiObject.DynamoDBStreamParameters.MaximumBatchingWindowInSeconds),
+			"starting_position":                  apiObject.DynamoDBStreamParameters.StartingPosition,
+		}}
+	}
+
+	if apiObject.SqsQueueParameters != nil {
+		tfMap["sqs_queue_parameters"] = []interface{}{map[string]interface{}{
+			"batch_size":                         aws.ToInt32(apiObject.SqsQueueParameters.BatchSize),
+			"maximum_batching_window_in_seconds": aws.ToInt32(apiObject.SqsQueueParameters.MaximumBatchingWindowInSeconds),
+		}}
+	}
+
+	if apiObject.ManagedStreamingKafkaParameters != nil {
+		tfMap["managed_streaming_kafka_parameters"] = []interface{}{map[string]interface{}{
+			"topic_name":                         aws.ToString(apiObject.ManagedStreamingKafkaParameters.TopicName),
+			"consumer_group_id":                  aws.ToString(apiObject.ManagedStreamingKafkaParameters.ConsumerGroupID),
+			"starting_position":                  apiObject.ManagedStreamingKafkaParameters.StartingPosition,
+			"batch_size":                         aws.ToInt32(apiObject.ManagedStreamingKafkaParameters.BatchSize),
+			"maximum_batching_window_in_seconds": aws.ToInt32(apiObject.ManagedStreamingKafkaParameters.MaximumBatchingWindowInSeconds),
+			"credentials":                        flattenPipeKafkaAccessCredentials(apiObject.ManagedStreamingKafkaParameters.Credentials),
+		}}
+	}
+
+	if apiObject.SelfManagedKafkaParameters != nil {
+		tfMap["self_managed_kafka_parameters"] = []interface{}{map[string]interface{}{
+			"topic_name":                         aws.ToString(apiObject.SelfManagedKafkaParameters.TopicName),
+			"consumer_group_id":                  aws.ToString(apiObject.SelfManagedKafkaParameters.ConsumerGroupID),
+			"batch_size":                         aws.ToInt32(apiObject.SelfManagedKafkaParameters.BatchSize),
+			"maximum_batching_window_in_seconds": aws.ToInt32(apiObject.SelfManagedKafkaParameters.MaximumBatchingWindowInSeconds),
+			"server_root_ca_certificate":         aws.ToString(apiObject.SelfManagedKafkaParameters.ServerRootCaCertificate),
+			"credentials":                        flattenPipeKafkaAccessCredentials(apiObject.SelfManagedKafkaParameters.Credentials),
+			"vpc":                                flattenPipeSelfManagedKafkaAccessConfigurationVPC(apiObject.SelfManagedKafkaParameters.Vpc),
+		}}
+	}
+
+	if apiObject.ActiveMQBrokerParameters != nil {
+		tfMap["activemq_broker_parameters"] = []interface{}{map[string]interface{}{
+			"queue_name":                         aws.ToString(apiObject.ActiveMQBrokerParameters.QueueName),
+			"batch_size":                         aws.ToInt32(apiObject.ActiveMQBrokerParameters.BatchSize),
+			"maximum_batching_window_in_seconds": aws.ToInt32(apiObject.ActiveMQBrokerParameters.MaximumBatchingWindowInSeconds),
+			"credentials":                        flattenPipeBrokerAccessCredentials(apiObject.ActiveMQBrokerParameters.Credentials),
+		}}
+	}
+
+	if apiObject.RabbitMQBrokerParameters != nil {
+		tfMap["rabbitmq_broker_parameters"] = []interface{}{map[string]interface{}{
+			"queue_name":                         aws.ToString(apiObject.RabbitMQBrokerParameters.QueueName),
+			"batch_size":                         aws.ToInt32(apiObject.RabbitMQBrokerParameters.BatchSize),
+			"maximum_batching_window_in_seconds": aws.ToInt32(apiObject.RabbitMQBrokerParameters.MaximumBatchingWindowInSeconds),
+			"credentials":                        flattenPipeBrokerAccessCredentials(apiObject.RabbitMQBrokerParameters.Credentials),
+		}}
+	}
+
+	if apiObject.FilterCriteria != nil {
+		filters := make([]interface{}, 0, len(apiObject.FilterCriteria.Filters))
+		for _, f := range apiObject.FilterCriteria.Filters {
+			filters = append(filters, map[string]interface{}{
+				"pattern": aws.ToString(f.Pattern),
+			})
+		}
+		tfMap["filter_criteria"] = []interface{}{map[string]interface{}{
+			"filter": filters,
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func expandPipeTargetParameters(tfMap map[string]interface{}) *awstypes.PipeTargetParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.PipeTargetParameters{}
+
+	if v, ok := tfMap["input_template"].(string); ok && v != "" {
+		apiObject.InputTemplate = aws.String(v)
+	}
+
+	if v, ok := tfMap["http_parameters"].([]interface{}); ok && len(v) > 0 {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.HttpParameters = &awstypes.PipeTargetHttpParameters{
+			HeaderParameters:      flex.ExpandStringValueMap(tfMap["header_parameters"].(map[string]interface{})),
+			PathParameterValues:   flex.ExpandStringValueList(tfMap["path_parameter_values"].([]interface{})),
+			QueryStringParameters: flex.ExpandStringValueMap(tfMap["query_string_parameters"].(map[string]interface{})),
+		}
+	}
+
+	if v, ok := tfMap["lambda_function_parameters"].([]interface{}); ok && len(v) > 0 {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.LambdaFunctionParameters = &awstypes.PipeTargetLambdaFunctionParameters{
+			InvocationType: awstypes.PipeTargetInvocationType(tfMap["invocation_type"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["step_function_state_machine_parameters"].([]interface{}); ok && len(v) > 0 {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.StepFunctionStateMachineParameters = &awstypes.PipeTargetStateMachineParameters{
+			InvocationType: awstypes.PipeTargetInvocationType(tfMap["invocation_type"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["sqs_queue_parameters"].([]interface{}); ok && len(v) > 0 {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.SqsQueueParameters = &awstypes.PipeTargetSqsQueueParameters{}
+
+		if v, ok := tfMap["message_deduplication_id"].(string); ok && v != "" {
+			apiObject.SqsQueueParameters.MessageDeduplicationId = aws.String(v)
+		}
+
+		if v, ok := tfMap["message_group_id"].(string); ok && v != "" {
+			apiObject.SqsQueueParameters.MessageGroupId = aws.String(v)
+		}
+	}
+
+	if v, ok := tfMap["kinesis_stream_parameters"].([]interface{}); ok && len(v) > 0 {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.KinesisStreamParameters = &awstypes.PipeTargetKinesisStreamParameters{
+			PartitionKey: aws.String(tfMap["partition_key"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["batch_job_parameters"].([]interface{}); ok && len(v) > 0 {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.BatchJobParameters = &awstypes.PipeTargetBatchJobParameters{
+			JobDefinition: aws.String(tfMap["job_definition"].(string)),
+			JobName:       aws.String(tfMap["job_name"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["ecs_task_parameters"].([]interface{}); ok && len(v) > 0 {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.EcsTaskParameters = &awstypes.PipeTargetEcsTaskParameters{
+			TaskDefinitionArn: aws.String(tfMap["task_definition_arn"].(string)),
+		}
+		if v, ok := tfMap["task_count"].(int); ok && v != 0 {
+			apiObject.EcsTaskParameters.TaskCount = aws.Int32(int32(v))
+		}
+	}
+
+	if v, ok := tfMap["eventbridge_event_bus_parameters"].([]interface{}); ok && len(v) > 0 {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.EventBridgeEventBusParameters = &awstypes.PipeTargetEventBridgeEventBusParameters{
+			Resources: flex.ExpandStringValueList(tfMap["resources"].([]interface{})),
+		}
+
+		if v, ok := tfMap["detail_type"].(string); ok && v != "" {
+			apiObject.EventBridgeEventBusParameters.DetailType = aws.String(v)
+		}
+
+		if v, ok := tfMap["endpoint_id"].(string); ok && v != "" {
+			apiObject.EventBridgeEventBusParameters.EndpointId = aws.String(v)
+		}
+
+		if v, ok := tfMap["source"].(string); ok && v != "" {
+			apiObject.EventBridgeEventBusParameters.Source = aws.String(v)
+		}
+	}
+
+	if v, ok := tfMap["redshift_data_parameters"].([]interface{}); ok && len(v) > 0 {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.RedshiftDataParameters = &awstypes.PipeTargetRedshiftDataParameters{
+			Database:  aws.String(tfMap["database"].(string)),
+			Sqls:      flex.ExpandStringValueList(tfMap["sqls"].([]interface{})),
+			WithEvent: aws.Bool(tfMap["with_event"].(bool)),
+		}
+
+		if v, ok := tfMap["db_user"].(string); ok && v != "" {
+			apiObject.RedshiftDataParameters.DbUser = aws.String(v)
+		}
+
+		if v, ok := tfMap["secret_manager_arn"].(string); ok && v != "" {
+			apiObject.RedshiftDataParameters.SecretManagerArn = aws.String(v)
+		}
+
+		if v, ok := tfMap["statement_name"].(string); ok && v != "" {
+			apiObject.RedshiftDataParameters.StatementName = aws.String(v)
+		}
+	}
+
+	if v, ok := tfMap["sagemaker_pipeline_parameters"].([]interface{}); ok && len(v) > 0 {
+		tfMap := v[0].(map[string]interface{})
+		params := make([]awstypes.SageMakerPipelineParameter, 0)
+		for _, raw := range tfMap["pipeline_parameter"].([]interface{}) {
+			p := raw.(map[string]interface{})
+			params = append(params, awstypes.SageMakerPipelineParameter{
+				Name:  aws.String(p["name"].(string)),
+				Value: aws.String(p["value"].(string)),
+			})
+		}
+		apiObject.SageMakerPipelineParameters = &awstypes.PipeTargetSageMakerPipelineParameters{
+			PipelineParameterList: params,
+		}
+	}
+
+	if v, ok := tfMap["timestream_parameters"].([]interface{}); ok && len(v) > 0 {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.TimestreamParameters = &awstypes.PipeTargetTimestreamParameters{
+			TimeValue:    aws.String(tfMap["time_value"].(string)),
+			VersionValue: aws.String(tfMap["version_value"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["cloudwatch_logs_parameters"].([]interface{}); ok && len(v) > 0 {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.CloudWatchLogsParameters = &awstypes.PipeTargetCloudWatchLogsParameters{}
+
+		if v, ok := tfMap["log_stream_name"].(string); ok && v != "" {
+			apiObject.CloudWatchLogsParameters.LogStreamName = aws.String(v)
+		}
+
+		if v, ok := tfMap["timestamp"].(string); ok && v != "" {
+			apiObject.CloudWatchLogsParameters.Timestamp = aws.String(v)
+		}
+	}
+
+	return apiObject
+}
+
+func flattenPipeTargetParameters(apiObject *awstypes.PipeTargetParameters) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"input_template": aws.ToString(apiObject.InputTemplate),
+	}
+
+	if apiObject.HttpParameters != nil {
+		tfMap["http_parameters"] = []interface{}{flattenPipeHTTPParameters(apiObject.HttpParameters)}
+	}
+
+	if apiObject.EventBridgeEventBusParameters != nil {
+		tfMap["eventbridge_event_bus_parameters"] = []interface{}{map[string]interface{}{
+			"detail_type": aws.ToString(apiObject.EventBridgeEventBusParameters.DetailType),
+			"endpoint_id": aws.ToString(apiObject.EventBridgeEventBusParameters.EndpointId),
+			"resources":   apiObject.EventBridgeEventBusParameters.Resources,
+			"source":      aws.ToString(apiObject.EventBridgeEventBusParameters.Source),
+		}}
+	}
+
+	if apiObject.SageMakerPipelineParameters != nil {
+		params := make([]interface{}, 0, len(apiObject.SageMakerPipelineParameters.PipelineParameterList))
+		for _, p := range apiObject.SageMakerPipelineParameters.PipelineParameterList {
+			params = append(params, map[string]interface{}{
+				"name":  aws.ToString(p.Name),
+				"value": aws.ToString(p.Value),
+			})
+		}
+		tfMap["sagemaker_pipeline_parameters"] = []interface{}{map[string]interface{}{
+			"pipeline_parameter": params,
+		}}
+	}
+
+	if apiObject.CloudWatchLogsParameters != nil {
+		tfMap["cloudwatch_logs_parameters"] = []interface{}{map[string]interface{}{
+			"log_stream_name": aws.ToString(apiObject.CloudWatchLogsParameters.LogStreamName),
+			"timestamp":       aws.ToString(apiObject.CloudWatchLogsParameters.Timestamp),
+		}}
+	}
+
+	if apiObject.LambdaFunctionParameters != nil {
+		tfMap["lambda_function_parameters"] = []interface{}{map[string]interface{}{
+			"invocation_type": apiObject.LambdaFunctionParameters.InvocationType,
+		}}
+	}
+
+	if apiObject.StepFunctionStateMachineParameters != nil {
+		tfMap["step_function_state_machine_parameters"] = []interface{}{map[string]interface{}{
+			"invocation_type": apiObject.StepFunctionStateMachineParameters.InvocationType,
+		}}
+	}
+
+	if apiObject.SqsQueueParameters != nil {
+		tfMap["sqs_queue_parameters"] = []interface{}{map[string]interface{}{
+			"message_deduplication_id": aws.ToString(apiObject.SqsQueueParameters.MessageDeduplicationId),
+			"message_group_id":         aws.ToString(apiObject.SqsQueueParameters.MessageGroupId),
+		}}
+	}
+
+	if apiObject.KinesisStreamParameters != nil {
+		tfMap["kinesis_stream_parameters"] = []interface{}{map[string]interface{}{
+			"partition_key": aws.ToString(apiObject.KinesisStreamParameters.PartitionKey),
+		}}
+	}
+
+	if apiObject.BatchJobParameters != nil {
+		tfMap["batch_job_parameters"] = []interface{}{map[string]interface{}{
+			"job_definition": aws.ToString(apiObject.BatchJobParameters.JobDefinition),
+			"job_name":       aws.ToString(apiObject.BatchJobParameters.JobName),
+		}}
+	}
+
+	if apiObject.EcsTaskParameters != nil {
+		tfMap["ecs_task_parameters"] = []interface{}{map[string]interface{}{
+			"task_definition_arn": aws.ToString(apiObject.EcsTaskParameters.TaskDefinitionArn),
+			"task_count":          aws.ToInt32(apiObject.EcsTaskParameters.TaskCount),
+		}}
+	}
+
+	if apiObject.RedshiftDataParameters != nil {
+		tfMap["redshift_data_parameters"] = []interface{}{map[string]interface{}{
+			"database":           aws.ToString(apiObject.RedshiftDataParameters.Database),
+			"db_user":            aws.ToString(apiObject.RedshiftDataParameters.DbUser),
+			"secret_manager_arn": aws.ToString(apiObject.RedshiftDataParameters.SecretManagerArn),
+			"sqls":               apiObject.RedshiftDataParameters.Sqls,
+			"statement_name":     aws.ToString(apiObject.RedshiftDataParameters.StatementName),
+			"with_event":         aws.ToBool(apiObject.RedshiftDataParameters.WithEvent),
+		}}
+	}
+
+	if apiObject.TimestreamParameters != nil {
+		tfMap["timestream_parameters"] = []interface{}{map[string]interface{}{
+			"time_value":    aws.ToString(apiObject.TimestreamParameters.TimeValue),
+			"version_value": aws.ToString(apiObject.TimestreamParameters.VersionValue),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
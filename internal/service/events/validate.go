@@ -137,3 +137,14 @@ var validNameCharClass = `[` + validNameChars + `]+`
 
 var validBusNameCharPattern = `/` + validNameChars
 var validBusNameCharClass = `[` + validBusNameCharPattern + `]+`
+
+// validBusNameOrARN and validateTargetID don't apply to Pipes: a pipe's
+// source/target/enrichment are always full ARNs (validated via
+// verify.ValidARN), and Pipes has no per-target "target_id" concept the way
+// aws_cloudwatch_event_target does, so there's no field here to reuse them on.
+//
+// https://docs.aws.amazon.com/eventbridge/latest/pipes-reference/API_CreatePipe.html
+var validPipeName = validation.All(
+	validation.StringLenBetween(1, 64),
+	validation.StringMatch(regexache.MustCompile(`^[\.\-_A-Za-z0-9]+$`), "must contain only alphanumeric, period, hyphen, and underscore characters"),
+)
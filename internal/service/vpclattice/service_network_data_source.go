@@ -7,13 +7,18 @@ import (
 	"context"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	awscreds "github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/aws/aws-sdk-go-v2/service/vpclattice"
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -55,6 +60,34 @@ func DataSourceServiceNetwork() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"tags_assume_role": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"external_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(2, 1224),
+						},
+						"policy": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsJSON,
+						},
+						"role_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"session_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
 			names.AttrTags: tftags.TagsSchemaComputed(),
 		},
 	}
@@ -100,6 +133,20 @@ func dataSourceServiceNetworkRead(ctx context.Context, d *schema.ResourceData, m
 	if parsedArn.AccountID == meta.(*conns.AWSClient).AccountID {
 		tags, err = listTags(ctx, conn, outArn)
 
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "listing tags for VPC Lattice Service Network (%s): %s", outArn, err)
+		}
+	} else if v, ok := d.GetOk("tags_assume_role"); ok && len(v.([]interface{})) > 0 {
+		// The caller doesn't own this service network (it was shared via RAM), but they
+		// have a delegated read role in the owner account that's allowed to list tags.
+		tagsConn, err := assumeRoleVPCLatticeClient(ctx, meta.(*conns.AWSClient), v.([]interface{})[0].(map[string]interface{}))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "assuming role to list tags for VPC Lattice Service Network (%s): %s", outArn, err)
+		}
+
+		tags, err = listTags(ctx, tagsConn, outArn)
+
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "listing tags for VPC Lattice Service Network (%s): %s", outArn, err)
 		}
@@ -111,3 +158,43 @@ func dataSourceServiceNetworkRead(ctx context.Context, d *schema.ResourceData, m
 
 	return diags
 }
+
+// assumeRoleVPCLatticeClient builds a VPC Lattice client scoped to the
+// credentials of the role described by tfMap. It's used exclusively for the
+// listTags call against a service network the caller doesn't own, leaving
+// all other reads on the caller's own client.
+func assumeRoleVPCLatticeClient(ctx context.Context, client *conns.AWSClient, tfMap map[string]interface{}) (*vpclattice.Client, error) {
+	stsClient := sts.NewFromConfig(client.AwsConfig(ctx))
+
+	input := &sts.AssumeRoleInput{
+		RoleArn:         aws.String(tfMap["role_arn"].(string)),
+		RoleSessionName: aws.String("terraform-provider-aws"),
+	}
+
+	if v, ok := tfMap["session_name"].(string); ok && v != "" {
+		input.RoleSessionName = aws.String(v)
+	}
+
+	if v, ok := tfMap["external_id"].(string); ok && v != "" {
+		input.ExternalId = aws.String(v)
+	}
+
+	if v, ok := tfMap["policy"].(string); ok && v != "" {
+		input.Policy = aws.String(v)
+	}
+
+	out, err := stsClient.AssumeRole(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := client.AwsConfig(ctx).Copy()
+	cfg.Credentials = awscreds.NewStaticCredentialsProvider(
+		aws.ToString(out.Credentials.AccessKeyId),
+		aws.ToString(out.Credentials.SecretAccessKey),
+		aws.ToString(out.Credentials.SessionToken),
+	)
+
+	return vpclattice.NewFromConfig(cfg), nil
+}
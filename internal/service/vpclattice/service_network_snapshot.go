@@ -0,0 +1,290 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vpclattice
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"log"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/vpclattice"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_vpclattice_service_network_snapshot", name="Service Network Snapshot")
+func ResourceServiceNetworkSnapshot() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceServiceNetworkSnapshotPut,
+		ReadWithoutTimeout:   resourceServiceNetworkSnapshotRead,
+		UpdateWithoutTimeout: resourceServiceNetworkSnapshotPut,
+		DeleteWithoutTimeout: resourceServiceNetworkSnapshotDelete,
+
+		CustomizeDiff: resourceServiceNetworkSnapshotCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"content_hash": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"s3_bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(3, 63),
+			},
+			"s3_key": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"s3_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"service_network_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+const (
+	ResNameServiceNetworkSnapshot = "Service Network Snapshot"
+)
+
+// serviceNetworkSnapshot is the JSON manifest written to S3. It captures
+// the associated services and VPCs of a service network at apply time so
+// users can build a cross-account inventory of Lattice topology without
+// stitching together the association data sources by hand.
+type serviceNetworkSnapshot struct {
+	ServiceNetworkID  string   `json:"service_network_id"`
+	ServiceNetworkARN string   `json:"service_network_arn"`
+	ServiceARNs       []string `json:"service_arns"`
+	VPCARNs           []string `json:"vpc_arns"`
+}
+
+// buildServiceNetworkSnapshot fetches the current state of a service network's
+// associations and returns both the marshaled manifest and its content hash.
+// It's shared by the CRUD handler and CustomizeDiff so drift detection and
+// the actual S3 write can never disagree on what "current" means.
+func buildServiceNetworkSnapshot(ctx context.Context, conn *vpclattice.Client, serviceNetworkID string) ([]byte, string, error) {
+	out, err := findServiceNetworkByID(ctx, conn, serviceNetworkID)
+
+	if err != nil {
+		return nil, "", err
+	}
+
+	serviceAssociations, err := findServiceNetworkServiceAssociationsByID(ctx, conn, serviceNetworkID)
+
+	if err != nil {
+		return nil, "", err
+	}
+
+	vpcAssociations, err := findServiceNetworkVPCAssociationsByID(ctx, conn, serviceNetworkID)
+
+	if err != nil {
+		return nil, "", err
+	}
+
+	snapshot := serviceNetworkSnapshot{
+		ServiceNetworkID:  aws.ToString(out.Id),
+		ServiceNetworkARN: aws.ToString(out.Arn),
+		ServiceARNs:       serviceAssociations,
+		VPCARNs:           vpcAssociations,
+	}
+
+	body, err := json.MarshalIndent(snapshot, "", "  ")
+
+	if err != nil {
+		return nil, "", err
+	}
+
+	hash := sha256.Sum256(body)
+
+	return body, hex.EncodeToString(hash[:]), nil
+}
+
+// findServiceNetworkServiceAssociationsByID returns the sorted ARNs of the
+// services currently associated with a service network.
+func findServiceNetworkServiceAssociationsByID(ctx context.Context, conn *vpclattice.Client, serviceNetworkID string) ([]string, error) {
+	input := &vpclattice.ListServiceNetworkServiceAssociationsInput{
+		ServiceNetworkIdentifier: aws.String(serviceNetworkID),
+	}
+
+	var arns []string
+	paginator := vpclattice.NewListServiceNetworkServiceAssociationsPaginator(conn, input)
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, assoc := range page.Items {
+			arns = append(arns, aws.ToString(assoc.ServiceArn))
+		}
+	}
+
+	sort.Strings(arns)
+
+	return arns, nil
+}
+
+// findServiceNetworkVPCAssociationsByID returns the sorted ARNs of the
+// service network's VPC associations, one per associated VPC. These are the
+// association ARNs, not the ARNs of the VPCs themselves.
+func findServiceNetworkVPCAssociationsByID(ctx context.Context, conn *vpclattice.Client, serviceNetworkID string) ([]string, error) {
+	input := &vpclattice.ListServiceNetworkVpcAssociationsInput{
+		ServiceNetworkIdentifier: aws.String(serviceNetworkID),
+	}
+
+	var arns []string
+	paginator := vpclattice.NewListServiceNetworkVpcAssociationsPaginator(conn, input)
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, assoc := range page.Items {
+			arns = append(arns, aws.ToString(assoc.Arn))
+		}
+	}
+
+	sort.Strings(arns)
+
+	return arns, nil
+}
+
+// resourceServiceNetworkSnapshotCustomizeDiff refetches the service network's
+// associations on every plan and marks content_hash as changing when the
+// freshly computed hash no longer matches what's stored in state. Since
+// s3_bucket/s3_prefix/service_network_identifier are all ForceNew, this is
+// the only path that surfaces drift in the underlying associations without
+// forcing a replacement.
+func resourceServiceNetworkSnapshotCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).VPCLatticeClient(ctx)
+
+	_, contentHash, err := buildServiceNetworkSnapshot(ctx, conn, diff.Get("service_network_identifier").(string))
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if contentHash != diff.Get("content_hash").(string) {
+		return diff.SetNewComputed("content_hash")
+	}
+
+	return nil
+}
+
+func resourceServiceNetworkSnapshotPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).VPCLatticeClient(ctx)
+	s3Conn := meta.(*conns.AWSClient).S3Client(ctx)
+
+	serviceNetworkID := d.Get("service_network_identifier").(string)
+
+	body, contentHash, err := buildServiceNetworkSnapshot(ctx, conn, serviceNetworkID)
+
+	if err != nil {
+		return create.DiagError(names.VPCLattice, create.ErrActionCreating, ResNameServiceNetworkSnapshot, serviceNetworkID, err)
+	}
+
+	if !d.IsNewResource() && d.Get("content_hash").(string) == contentHash {
+		return append(diags, resourceServiceNetworkSnapshotRead(ctx, d, meta)...)
+	}
+
+	key := serviceNetworkSnapshotKey(d.Get("s3_prefix").(string), serviceNetworkID)
+	bucket := d.Get("s3_bucket").(string)
+
+	_, err = s3Conn.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:      aws.String(bucket),
+		Key:         aws.String(key),
+		Body:        bytes.NewReader(body),
+		ContentType: aws.String("application/json"),
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "writing Service Network (%s) snapshot to s3://%s/%s: %s", serviceNetworkID, bucket, key, err)
+	}
+
+	d.SetId(serviceNetworkID)
+	d.Set("content_hash", contentHash)
+	d.Set("s3_key", key)
+
+	return append(diags, resourceServiceNetworkSnapshotRead(ctx, d, meta)...)
+}
+
+func resourceServiceNetworkSnapshotRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).VPCLatticeClient(ctx)
+
+	_, err := findServiceNetworkByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] VPC Lattice Service Network Snapshot (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return create.DiagError(names.VPCLattice, create.ErrActionReading, ResNameServiceNetworkSnapshot, d.Id(), err)
+	}
+
+	d.Set("service_network_identifier", d.Id())
+
+	return diags
+}
+
+func resourceServiceNetworkSnapshotDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	s3Conn := meta.(*conns.AWSClient).S3Client(ctx)
+
+	log.Printf("[INFO] Deleting VPC Lattice Service Network Snapshot: %s", d.Id())
+	_, err := s3Conn.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(d.Get("s3_bucket").(string)),
+		Key:    aws.String(d.Get("s3_key").(string)),
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Service Network (%s) snapshot: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func serviceNetworkSnapshotKey(prefix, serviceNetworkID string) string {
+	if prefix == "" {
+		return serviceNetworkID + ".json"
+	}
+
+	return prefix + "/" + serviceNetworkID + ".json"
+}